@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/NumberUn/go_bot/pkg/exchange"
+	"github.com/NumberUn/go_bot/pkg/exchange/btse"
+	"github.com/NumberUn/go_bot/pkg/exchange/whitebit"
+	"github.com/NumberUn/go_bot/pkg/finder"
+)
+
+const (
+	configLocation  = "config.ini"
+	spreadThreshold = 10.0 // bps
+	maxBookAge      = 2 * time.Second
+)
+
+// venue bundles everything watchOrderBook needs to turn an exchange's raw
+// order book update into a finder.VenueQuote: which base asset each
+// symbol belongs to, and the fee/sizing terms to cost an opportunity with.
+type venue struct {
+	exchange exchange.Exchange
+	symbols  []string
+	baseOf   map[string]string
+	feeBps   float64
+}
+
+// watchConnectionState tracks an exchange's public feed health, if it
+// exposes one, and flips degraded so watchOrderBook can pause feeding the
+// finder while the feed is mid-reconnect instead of acting on a book it
+// knows is stale.
+func watchConnectionState(ex exchange.Exchange, degraded *atomic.Bool) {
+	btseClient, ok := ex.(*btse.Client)
+	if !ok {
+		return
+	}
+	for state := range btseClient.ConnectionState() {
+		degraded.Store(state != btse.StateConnected)
+		if state != btse.StateConnected {
+			fmt.Println(ex.Name(), "public feed degraded:", state)
+		}
+	}
+}
+
+func watchOrderBook(v venue, f *finder.Finder) {
+	var degraded atomic.Bool
+	go watchConnectionState(v.exchange, &degraded)
+
+	updates, err := v.exchange.SubscribeOrderBook(v.symbols)
+	if err != nil {
+		log.Fatalf("%s: subscribe order book: %v", v.exchange.Name(), err)
+	}
+	for update := range updates {
+		fmt.Println("New OB:", update.Exchange, update)
+
+		if degraded.Load() {
+			// Feed is mid-reconnect; don't let the finder act on a book
+			// that may already be stale.
+			continue
+		}
+		if len(update.Bids) == 0 || len(update.Asks) == 0 {
+			continue
+		}
+		if update.Bids[0].Price == 0 || update.Asks[0].Price == 0 {
+			continue
+		}
+		base, ok := v.baseOf[update.Symbol]
+		if !ok {
+			continue
+		}
+
+		contractValue := 1.0
+		var minSize float64
+		if btseClient, ok := v.exchange.(*btse.Client); ok {
+			if instrument, ok := btseClient.Instrument(update.Symbol); ok {
+				contractValue = instrument.ContractValue
+				minSize = instrument.MinSize
+			}
+		}
+
+		f.Update(finder.VenueQuote{
+			Exchange:      update.Exchange,
+			Base:          base,
+			Bid:           update.Bids[0],
+			Ask:           update.Asks[0],
+			MinSize:       minSize,
+			ContractValue: contractValue,
+			FeeBps:        v.feeBps,
+			Timestamp:     update.Timestamp,
+		})
+	}
+}
+
+func watchOpportunities(f *finder.Finder) {
+	for opp := range f.Opportunities() {
+		fmt.Printf("Spread opportunity: buy %s on %s @ %.4f, sell on %s @ %.4f, size %.4f, %.1f bps\n",
+			opp.Base, opp.BuyVenue, opp.BuyPrice, opp.SellVenue, opp.SellPrice, opp.MaxSize, opp.Bps)
+	}
+}
+
+func main() {
+	btseClient := btse.NewClient()
+	if err := btseClient.LoadApiKeys(configLocation); err != nil {
+		log.Fatalf("BTSE: %v", err)
+	}
+
+	var btseSymbols []string
+	btseBase := make(map[string]string)
+	for _, market := range btseClient.Markets() {
+		btseSymbols = append(btseSymbols, market.Symbol)
+		btseBase[market.Symbol] = market.Base
+	}
+
+	whitebitClient := whitebit.NewClient()
+	whitebitSymbols := []string{"BTC_PERP"}
+	whitebitBase := map[string]string{"BTC_PERP": "BTC"}
+
+	spreadFinder := finder.New(spreadThreshold, maxBookAge)
+
+	venues := []venue{
+		{exchange: btseClient, symbols: btseSymbols, baseOf: btseBase, feeBps: 6},
+		{exchange: whitebitClient, symbols: whitebitSymbols, baseOf: whitebitBase, feeBps: 10},
+	}
+
+	for _, v := range venues {
+		go watchOrderBook(v, spreadFinder)
+	}
+	go watchOpportunities(spreadFinder)
+
+	btseClient.OnOrderUpdate(func(update btse.OrderUpdate) {
+		fmt.Println("Order update:", update)
+	})
+	btseClient.OnFill(func(fill btse.FillUpdate) {
+		fmt.Println("Fill:", fill)
+	})
+	if err := btseClient.SubscribePrivate(); err != nil {
+		log.Fatalf("BTSE: subscribe private: %v", err)
+	}
+
+	select {}
+}
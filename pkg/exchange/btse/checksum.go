@@ -0,0 +1,98 @@
+package btse
+
+import (
+	"hash/crc32"
+	"strings"
+	"sync"
+
+	"github.com/NumberUn/go_bot/pkg/orderbook"
+)
+
+const checksumDepth = 100
+
+// rawBook mirrors a symbol's orderbook.OrderBook but keeps the literal
+// price/size strings BTSE published on the wire, keyed by the float64
+// price orderbook.OrderBook sorts on. BTSE computes its checksum from
+// those literal decimal strings, so reformatting the parsed float64s
+// (e.g. via strconv.FormatFloat's shortest round-trip form) silently
+// drops trailing zeros a venue's declared precision relies on and the
+// checksum would never match.
+type rawBook struct {
+	mu   sync.Mutex
+	bids map[float64][2]string // price -> [priceStr, sizeStr]
+	asks map[float64][2]string
+}
+
+func newRawBook() *rawBook {
+	return &rawBook{
+		bids: make(map[float64][2]string),
+		asks: make(map[float64][2]string),
+	}
+}
+
+func (rb *rawBook) update(side orderbook.Side, price float64, priceStr, sizeStr string) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	levels := rb.bids
+	if side == orderbook.Ask {
+		levels = rb.asks
+	}
+	if sizeStr == "0" {
+		delete(levels, price)
+		return
+	}
+	levels[price] = [2]string{priceStr, sizeStr}
+}
+
+func (rb *rawBook) lookup(side orderbook.Side, price float64) (priceStr, sizeStr string, ok bool) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	levels := rb.bids
+	if side == orderbook.Ask {
+		levels = rb.asks
+	}
+	pair, ok := levels[price]
+	return pair[0], pair[1], ok
+}
+
+// levelStrings returns the literal price/size strings for the top
+// checksumDepth levels on side, in the same best-price-first order
+// orderbook.OrderBook.TopN sorts on.
+func levelStrings(ob *orderbook.OrderBook, raw *rawBook, side orderbook.Side) [][2]string {
+	levels := ob.TopN(side, checksumDepth)
+	out := make([][2]string, 0, len(levels))
+	for _, level := range levels {
+		priceStr, sizeStr, ok := raw.lookup(side, level.Price)
+		if !ok {
+			continue
+		}
+		out = append(out, [2]string{priceStr, sizeStr})
+	}
+	return out
+}
+
+// checksum follows the FTX-style scheme BTSE also publishes: interleave
+// the top 100 bids and asks by depth index as
+// "bid0:bid0size:ask0:ask0size:bid1:bid1size:ask1:ask1size...",
+// continuing with whichever side still has levels once the other is
+// exhausted, using the literal strings BTSE published on the wire (not a
+// reformatting of the parsed float64s), CRC32 the result, and cast to
+// int32 to match the signed checksum field the venue sends.
+func checksum(ob *orderbook.OrderBook, raw *rawBook) int32 {
+	bids := levelStrings(ob, raw, orderbook.Bid)
+	asks := levelStrings(ob, raw, orderbook.Ask)
+
+	var parts []string
+	for i := 0; i < len(bids) || i < len(asks); i++ {
+		if i < len(bids) {
+			parts = append(parts, bids[i][0], bids[i][1])
+		}
+		if i < len(asks) {
+			parts = append(parts, asks[i][0], asks[i][1])
+		}
+	}
+	s := strings.Join(parts, ":")
+	return int32(crc32.ChecksumIEEE([]byte(s)))
+}
@@ -0,0 +1,105 @@
+package btse
+
+import (
+	"hash/crc32"
+	"testing"
+
+	"github.com/NumberUn/go_bot/pkg/orderbook"
+)
+
+func TestChecksumInterleavesBidsAndAsks(t *testing.T) {
+	tests := []struct {
+		name string
+		bids [][]string
+		asks [][]string
+		want string // expected ":"-joined string fed to crc32.ChecksumIEEE
+	}{
+		{
+			name: "equal depth",
+			bids: [][]string{{"100.50", "1.0"}, {"100.00", "2.0"}},
+			asks: [][]string{{"101.00", "1.5"}, {"101.50", "2.5"}},
+			want: "100.50:1.0:101.00:1.5:100.00:2.0:101.50:2.5",
+		},
+		{
+			name: "more bids than asks",
+			bids: [][]string{{"100.50", "1.0"}, {"100.00", "2.0"}, {"99.50", "3.0"}},
+			asks: [][]string{{"101.00", "1.5"}},
+			want: "100.50:1.0:101.00:1.5:100.00:2.0:99.50:3.0",
+		},
+		{
+			name: "more asks than bids",
+			bids: [][]string{{"100.50", "1.0"}},
+			asks: [][]string{{"101.00", "1.5"}, {"101.50", "2.5"}},
+			want: "100.50:1.0:101.00:1.5:101.50:2.5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ob := orderbook.New("TEST")
+			raw := newRawBook()
+			applyLevels(ob, raw, orderbook.Bid, tt.bids)
+			applyLevels(ob, raw, orderbook.Ask, tt.asks)
+
+			got := checksum(ob, raw)
+			want := int32(crc32.ChecksumIEEE([]byte(tt.want)))
+			if got != want {
+				t.Fatalf("checksum() = %d, want %d (from %q)", got, want, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateOrderBookAcceptsMatchingChecksum(t *testing.T) {
+	c := NewClient()
+
+	msg := WsOrderbookResp{Data: OrderbookData{
+		Symbol: "BTCPFC",
+		Bids:   [][]string{{"100.50", "1.0"}},
+		Asks:   [][]string{{"101.00", "1.5"}},
+		SeqNum: 1,
+	}}
+	msg.Data.Checksum = int32(crc32.ChecksumIEEE([]byte("100.50:1.0:101.00:1.5")))
+
+	update, ok := c.updateOrderBook(msg)
+	if !ok {
+		t.Fatal("updateOrderBook() ok = false, want true for a matching checksum")
+	}
+	if update.Bids[0].Price != 100.50 || update.Asks[0].Price != 101.00 {
+		t.Fatalf("updateOrderBook() = %+v, unexpected top of book", update)
+	}
+}
+
+func TestUpdateOrderBookRejectsMismatchedChecksum(t *testing.T) {
+	c := NewClient()
+
+	msg := WsOrderbookResp{Data: OrderbookData{
+		Symbol:   "BTCPFC",
+		Bids:     [][]string{{"100.50", "1.0"}},
+		Asks:     [][]string{{"101.00", "1.5"}},
+		SeqNum:   1,
+		Checksum: 12345, // deliberately wrong
+	}}
+
+	_, ok := c.updateOrderBook(msg)
+	if ok {
+		t.Fatal("updateOrderBook() ok = true, want false for a mismatched checksum")
+	}
+
+	c.mu.RLock()
+	_, seqSeen := c.seqNum["BTCPFC"]
+	c.mu.RUnlock()
+	if !seqSeen {
+		t.Fatal("seqNum should still be recorded after a checksum mismatch; resyncSymbol is what clears it")
+	}
+
+	c.resyncSymbol("BTCPFC")
+
+	c.mu.RLock()
+	_, obExists := c.orderbook["BTCPFC"]
+	_, seqSeen = c.seqNum["BTCPFC"]
+	c.mu.RUnlock()
+	if obExists || seqSeen {
+		t.Fatal("resyncSymbol should drop both the local book and sequence state")
+	}
+}
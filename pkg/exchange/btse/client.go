@@ -0,0 +1,195 @@
+// Package btse implements the exchange.Exchange interface for BTSE
+// futures, both public market data and (see rest.go/private.go) private
+// trading endpoints.
+package btse
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NumberUn/go_bot/pkg/orderbook"
+	"github.com/NumberUn/go_bot/pkg/types"
+	"gopkg.in/ini.v1"
+)
+
+const (
+	name          = "BTSE"
+	wsPublicAddr  = "ws.btse.com"
+	wsPublicPath  = "/ws/oss/futures"
+	getMarketsUrl = "https://api.btse.com/futures/api/v2.1/market_summary"
+)
+
+// Config holds the API credentials used for BTSE's private endpoints.
+type Config struct {
+	ApiKey    string
+	ApiSecret string
+}
+
+// Client is a stateful BTSE adapter: it owns the public/private websocket
+// connections and the local order book cache for the symbols it has been
+// asked to subscribe to.
+type Client struct {
+	config Config
+
+	mu            sync.RWMutex
+	markets       map[string]types.Market
+	instrument    map[string]types.Instrument
+	orderbook     map[string]*orderbook.OrderBook
+	rawLevels     map[string]*rawBook
+	seqNum        map[string]int64
+	states        chan ConnectionState
+	privateStates chan ConnectionState
+	callbacks     privateCallbacks
+
+	httpClient  *http.Client
+	restLimiter *restLimiter
+}
+
+// NewClient builds a BTSE client and eagerly loads the current market
+// summary so Markets() is populated before any subscription is made.
+func NewClient() *Client {
+	c := &Client{
+		markets:       make(map[string]types.Market),
+		instrument:    make(map[string]types.Instrument),
+		orderbook:     make(map[string]*orderbook.OrderBook),
+		rawLevels:     make(map[string]*rawBook),
+		seqNum:        make(map[string]int64),
+		states:        make(chan ConnectionState, 1),
+		privateStates: make(chan ConnectionState, 1),
+
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		restLimiter: newRestLimiter(),
+	}
+	c.loadMarkets(getMarketsUrl)
+	return c
+}
+
+// Name identifies this adapter in types.OrderBookUpdate.Exchange.
+func (c *Client) Name() string {
+	return name
+}
+
+// Markets returns the tradeable BTSE futures contracts, keyed by base asset.
+func (c *Client) Markets() map[string]types.Market {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	markets := make(map[string]types.Market, len(c.markets))
+	for base, market := range c.markets {
+		markets[base] = market
+	}
+	return markets
+}
+
+// Instrument returns the precision/sizing details loaded for symbol, as
+// used by RoundPrice/RoundSize and by the cross-exchange spread finder.
+func (c *Client) Instrument(symbol string) (types.Instrument, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	instrument, ok := c.instrument[symbol]
+	return instrument, ok
+}
+
+// LoadApiKeys reads the BTSE API key/secret from the given ini config file,
+// under a section named after the exchange.
+func (c *Client) LoadApiKeys(configLocation string) error {
+	cfg, err := ini.Load(configLocation)
+	if err != nil {
+		return fmt.Errorf("fail to read file: %w", err)
+	}
+
+	section, err := cfg.GetSection(name)
+	if err != nil {
+		return fmt.Errorf("fail to get section: %w", err)
+	}
+
+	apiKey, err := section.GetKey("API_KEY")
+	if err != nil {
+		return fmt.Errorf("fail to get 'API_KEY': %w", err)
+	}
+
+	apiSecret, err := section.GetKey("API_SECRET")
+	if err != nil {
+		return fmt.Errorf("fail to get 'API_SECRET': %w", err)
+	}
+
+	c.config.ApiKey = apiKey.String()
+	c.config.ApiSecret = apiSecret.String()
+	return nil
+}
+
+func getPricePrecision(tickSize float64) int {
+	var pricePrecision int
+	tickSizeStr := fmt.Sprintf("%v", tickSize)
+	if strings.Contains(tickSizeStr, ".") {
+		parts := strings.Split(tickSizeStr, ".")
+		pricePrecision = len(parts[1])
+	} else if strings.Contains(tickSizeStr, "-") {
+		parts := strings.Split(tickSizeStr, "-")
+		pricePrecision, _ = strconv.Atoi(parts[1])
+	} else {
+		pricePrecision = 0
+	}
+	return pricePrecision
+}
+
+func getQuantityPrecision(stepSize float64) int {
+	var quantityPrecision int
+	if strings.Contains(fmt.Sprintf("%v", stepSize), ".") {
+		parts := strings.Split(fmt.Sprintf("%v", stepSize), ".")
+		quantityPrecision = len(parts[1])
+	} else {
+		quantityPrecision = 1
+	}
+	return quantityPrecision
+}
+
+func (c *Client) updateInstrument(market types.Market) {
+	tickSize := market.MinSizeIncrement
+	pricePrecision := getPricePrecision(tickSize)
+	contractSize := market.ContractSize
+	stepSize := tickSize * contractSize
+	quantityPrecision := getQuantityPrecision(stepSize)
+	minSize := market.MinOrderSize * contractSize
+
+	c.instrument[market.Symbol] = types.Instrument{
+		ContractValue:     contractSize,
+		TickSize:          tickSize,
+		StepSize:          stepSize,
+		QuantityPrecision: quantityPrecision,
+		PricePrecision:    pricePrecision,
+		MinSize:           minSize,
+	}
+}
+
+func (c *Client) loadMarkets(url string) {
+	resp, err := http.Get(url)
+	if err != nil {
+		fmt.Println("Error fetching markets:", err)
+		return
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Println("Error reading response body:", err)
+		return
+	}
+
+	var markets []types.Market
+	if err := json.Unmarshal(body, &markets); err != nil {
+		fmt.Println("Error unmarshalling JSON:", err)
+		return
+	}
+
+	for _, market := range markets {
+		if market.Active && strings.Contains(market.Symbol, "PFC") {
+			c.markets[market.Base] = market
+			c.updateInstrument(market)
+		}
+	}
+}
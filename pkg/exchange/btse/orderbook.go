@@ -0,0 +1,91 @@
+package btse
+
+import (
+	"strconv"
+
+	"github.com/NumberUn/go_bot/pkg/orderbook"
+	"github.com/NumberUn/go_bot/pkg/types"
+)
+
+// WsOrderbookResp is the envelope BTSE wraps every order book delta in.
+type WsOrderbookResp struct {
+	Topic string        `json:"topic"`
+	Data  OrderbookData `json:"data"`
+}
+
+// OrderbookData is the payload of a single order book delta or snapshot.
+type OrderbookData struct {
+	Bids       [][]string `json:"bids"`
+	Asks       [][]string `json:"asks"`
+	SeqNum     int64      `json:"seqNum"`
+	PrevSeqNum int64      `json:"prevSeqNum"`
+	Type       string     `json:"type"`
+	Symbol     string     `json:"symbol"`
+	Timestamp  int64      `json:"timestamp"`
+	Checksum   int32      `json:"checksum"`
+}
+
+func applyLevels(ob *orderbook.OrderBook, raw *rawBook, side orderbook.Side, levels [][]string) {
+	for _, level := range levels {
+		priceStr, sizeStr := level[0], level[1]
+		price, _ := strconv.ParseFloat(priceStr, 64)
+		size, _ := strconv.ParseFloat(sizeStr, 64)
+		ob.Update(side, price, size)
+		raw.update(side, price, priceStr, sizeStr)
+	}
+}
+
+// updateOrderBook applies a delta to the client's local book for symbol and
+// returns the venue-agnostic update to publish on the subscription
+// channel. ok is false when the delta was a sequence gap or failed its
+// checksum; the caller must then drop the book and resubscribe, see
+// resyncSymbol.
+func (c *Client) updateOrderBook(msg WsOrderbookResp) (update types.OrderBookUpdate, ok bool) {
+	symbol := msg.Data.Symbol
+
+	c.mu.Lock()
+	lastSeqNum, seen := c.seqNum[symbol]
+	if seen && msg.Data.PrevSeqNum != lastSeqNum {
+		c.mu.Unlock()
+		return types.OrderBookUpdate{}, false
+	}
+	ob, exists := c.orderbook[symbol]
+	if !exists {
+		ob = orderbook.New(symbol)
+		c.orderbook[symbol] = ob
+	}
+	raw, exists := c.rawLevels[symbol]
+	if !exists {
+		raw = newRawBook()
+		c.rawLevels[symbol] = raw
+	}
+	c.seqNum[symbol] = msg.Data.SeqNum
+	c.mu.Unlock()
+
+	applyLevels(ob, raw, orderbook.Bid, msg.Data.Bids)
+	applyLevels(ob, raw, orderbook.Ask, msg.Data.Asks)
+
+	if msg.Data.Checksum != 0 && checksum(ob, raw) != msg.Data.Checksum {
+		return types.OrderBookUpdate{}, false
+	}
+
+	bid, ask := ob.BestBidAsk()
+	return types.OrderBookUpdate{
+		Exchange:  name,
+		Symbol:    symbol,
+		Bids:      []types.PriceLevel{bid},
+		Asks:      []types.PriceLevel{ask},
+		Timestamp: msg.Data.Timestamp,
+	}, true
+}
+
+// resyncSymbol drops the local book and sequence state for symbol after a
+// checksum failure or sequence gap, so the next snapshot rebuilds it from
+// scratch instead of compounding corrupted state.
+func (c *Client) resyncSymbol(symbol string) {
+	c.mu.Lock()
+	delete(c.orderbook, symbol)
+	delete(c.rawLevels, symbol)
+	delete(c.seqNum, symbol)
+	c.mu.Unlock()
+}
@@ -0,0 +1,282 @@
+package btse
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const wsPrivatePath = "/ws/futures"
+
+// OrderUpdate is a single order state change delivered over the private
+// order-update channel.
+type OrderUpdate struct {
+	OrderID   string
+	Symbol    string
+	Side      string
+	Price     float64
+	Size      float64
+	Status    string
+	Timestamp int64
+}
+
+// FillUpdate is a single execution delivered over the private fills
+// channel.
+type FillUpdate struct {
+	OrderID   string
+	Symbol    string
+	Side      string
+	Price     float64
+	Size      float64
+	Fee       float64
+	Timestamp int64
+}
+
+// wsOrderUpdateResp and wsFillUpdateResp mirror the public WsOrderbookResp
+// envelope: a topic plus a typed data payload.
+type wsOrderUpdateResp struct {
+	Topic string        `json:"topic"`
+	Data  []orderUpdate `json:"data"`
+}
+
+type wsFillUpdateResp struct {
+	Topic string      `json:"topic"`
+	Data  []fillEvent `json:"data"`
+}
+
+type orderUpdate struct {
+	OrderID   string  `json:"orderID"`
+	Symbol    string  `json:"symbol"`
+	Side      string  `json:"side"`
+	Price     float64 `json:"price"`
+	Size      float64 `json:"size"`
+	Status    string  `json:"orderState"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+type fillEvent struct {
+	OrderID   string  `json:"orderID"`
+	Symbol    string  `json:"symbol"`
+	Side      string  `json:"side"`
+	Price     float64 `json:"price"`
+	Size      float64 `json:"size"`
+	Fee       float64 `json:"feeAmount"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+func generateSignature(apiSecret, path, nonce, data string) string {
+	message := path + nonce + data
+	hasher := hmac.New(sha512.New384, []byte(apiSecret))
+	hasher.Write([]byte(message))
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+func nonce() string {
+	return strconv.FormatInt(time.Now().UnixNano()/1e6+int64(rand.Intn(201)-100), 10)
+}
+
+// login performs BTSE's private websocket handshake: an empty-body
+// HMAC-SHA384 signature over the private path and a nonce, sent as an
+// "authKeyExpires" op.
+func (c *Client) login(conn *websocket.Conn) error {
+	n := nonce()
+	signature := generateSignature(c.config.ApiSecret, wsPrivatePath, n, "")
+
+	auth := Subscription{
+		Op:   "authKeyExpires",
+		Args: []string{c.config.ApiKey, n, signature},
+	}
+	message, err := json.Marshal(auth)
+	if err != nil {
+		return fmt.Errorf("marshal login: %w", err)
+	}
+	return conn.WriteMessage(websocket.TextMessage, message)
+}
+
+// privateCallbacks holds the strategy-registered callbacks invoked as
+// order and fill events arrive on the private channel.
+type privateCallbacks struct {
+	mu      sync.RWMutex
+	onOrder []func(OrderUpdate)
+	onFill  []func(FillUpdate)
+}
+
+// OnOrderUpdate registers a callback invoked for every order state change
+// received on the private channel.
+func (c *Client) OnOrderUpdate(cb func(OrderUpdate)) {
+	c.callbacks.mu.Lock()
+	defer c.callbacks.mu.Unlock()
+	c.callbacks.onOrder = append(c.callbacks.onOrder, cb)
+}
+
+// OnFill registers a callback invoked for every execution received on the
+// private channel.
+func (c *Client) OnFill(cb func(FillUpdate)) {
+	c.callbacks.mu.Lock()
+	defer c.callbacks.mu.Unlock()
+	c.callbacks.onFill = append(c.callbacks.onFill, cb)
+}
+
+func (c *Client) dispatchOrderUpdate(u OrderUpdate) {
+	c.callbacks.mu.RLock()
+	defer c.callbacks.mu.RUnlock()
+	for _, cb := range c.callbacks.onOrder {
+		cb(u)
+	}
+}
+
+func (c *Client) dispatchFill(f FillUpdate) {
+	c.callbacks.mu.RLock()
+	defer c.callbacks.mu.RUnlock()
+	for _, cb := range c.callbacks.onFill {
+		cb(f)
+	}
+}
+
+func (c *Client) readPrivateMessages(conn *websocket.Conn) {
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			log.Println("private read:", err)
+			return
+		}
+
+		var envelope struct {
+			Topic string `json:"topic"`
+		}
+		if err := json.Unmarshal(message, &envelope); err != nil {
+			log.Println("private unmarshal:", err)
+			continue
+		}
+
+		switch envelope.Topic {
+		case "notificationApiV2":
+			var resp wsOrderUpdateResp
+			if err := json.Unmarshal(message, &resp); err != nil {
+				log.Println("order update unmarshal:", err)
+				continue
+			}
+			for _, o := range resp.Data {
+				c.dispatchOrderUpdate(OrderUpdate{
+					OrderID:   o.OrderID,
+					Symbol:    o.Symbol,
+					Side:      o.Side,
+					Price:     o.Price,
+					Size:      o.Size,
+					Status:    o.Status,
+					Timestamp: o.Timestamp,
+				})
+			}
+		case "fills":
+			var resp wsFillUpdateResp
+			if err := json.Unmarshal(message, &resp); err != nil {
+				log.Println("fill unmarshal:", err)
+				continue
+			}
+			for _, f := range resp.Data {
+				c.dispatchFill(FillUpdate{
+					OrderID:   f.OrderID,
+					Symbol:    f.Symbol,
+					Side:      f.Side,
+					Price:     f.Price,
+					Size:      f.Size,
+					Fee:       f.Fee,
+					Timestamp: f.Timestamp,
+				})
+			}
+		}
+	}
+}
+
+// PrivateConnectionState returns a channel on which the client publishes
+// its private websocket connection health, the same way ConnectionState
+// does for the public order book feed.
+func (c *Client) PrivateConnectionState() <-chan ConnectionState {
+	return c.privateStates
+}
+
+func (c *Client) publishPrivateState(state ConnectionState) {
+	for {
+		select {
+		case c.privateStates <- state:
+			return
+		default:
+		}
+		// Buffer full: discard the unread older state so the newest wins.
+		select {
+		case <-c.privateStates:
+		default:
+		}
+	}
+}
+
+// runPrivateWebsocket is the private feed's supervised connection
+// manager, mirroring runOrderbookWebsocket: on any read error or
+// missed-pong disconnect it redials, re-runs the login handshake (each
+// attempt needs a fresh nonce anyway), resubscribes, and applies
+// exponential backoff with jitter between attempts, so a network blip
+// doesn't silently stop order/fill delivery.
+func (c *Client) runPrivateWebsocket() {
+	backoff := initialBackoff
+
+	for {
+		c.publishPrivateState(StateConnecting)
+
+		conn, err := connect(wsPrivatePath)
+		if err != nil {
+			log.Println("private connect:", err)
+			c.publishPrivateState(StateDisconnected)
+			time.Sleep(backoffWithJitter(backoff))
+			backoff = nextBackoff(backoff)
+			continue
+		}
+
+		if err := c.login(conn); err != nil {
+			log.Println("private login:", err)
+			conn.Close()
+			c.publishPrivateState(StateDisconnected)
+			time.Sleep(backoffWithJitter(backoff))
+			backoff = nextBackoff(backoff)
+			continue
+		}
+
+		if err := subscribeOrderbook(conn, []string{"notificationApiV2", "fills"}); err != nil {
+			log.Println("private subscribe:", err)
+			conn.Close()
+			c.publishPrivateState(StateDisconnected)
+			time.Sleep(backoffWithJitter(backoff))
+			backoff = nextBackoff(backoff)
+			continue
+		}
+
+		c.publishPrivateState(StateConnected)
+		backoff = initialBackoff
+
+		go pingWs(conn)
+		c.readPrivateMessages(conn)
+		conn.Close()
+
+		c.publishPrivateState(StateDisconnected)
+		time.Sleep(backoffWithJitter(backoff))
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// SubscribePrivate starts the supervised private websocket connection
+// manager, which authenticates with the client's API credentials and
+// subscribes to the order-update and fill channels so OnOrderUpdate/
+// OnFill callbacks fire as executions happen, without strategies having
+// to poll REST or having the feed silently go dark on a reconnect.
+func (c *Client) SubscribePrivate() error {
+	go c.runPrivateWebsocket()
+	return nil
+}
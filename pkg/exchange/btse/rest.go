@@ -0,0 +1,319 @@
+package btse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	restBaseURL   = "https://api.btse.com/futures/api/v2.1"
+	maxRetries    = 5
+	retryBaseWait = 200 * time.Millisecond
+	retryMaxWait  = 10 * time.Second
+)
+
+// APIError is returned for any BTSE REST response with a 4xx or 5xx
+// status (for 429 and 5xx, the last one once retries run out), so callers
+// can branch on the venue's own error code instead of an opaque HTTP
+// status. A 2xx response whose body does not decode returns the JSON
+// decoding error instead.
+type APIError struct {
+	Code       int
+	Message    string
+	HTTPStatus int
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("btse: %s (code %d, http %d)", e.Message, e.Code, e.HTTPStatus)
+}
+
+// Order is BTSE's typed order representation, returned by PlaceOrder,
+// CancelOrder, AmendOrder, and GetOpenOrders.
+type Order struct {
+	OrderID string  `json:"orderID"`
+	Symbol  string  `json:"symbol"`
+	Side    string  `json:"side"`
+	Price   float64 `json:"price"`
+	Size    float64 `json:"size"`
+	Status  string  `json:"orderState"`
+}
+
+// Position is a single open futures position as returned by GetPositions.
+type Position struct {
+	Symbol       string  `json:"symbol"`
+	Size         float64 `json:"size"`
+	EntryPrice   float64 `json:"entryPrice"`
+	MarkPrice    float64 `json:"markPrice"`
+	UnrealisedPL float64 `json:"unrealizedProfitLoss"`
+}
+
+// Account is the futures account balance summary returned by GetAccount.
+type Account struct {
+	Currency        string  `json:"currency"`
+	TotalValue      float64 `json:"totalValue"`
+	AvailableMargin float64 `json:"availableMargin"`
+}
+
+// restLimiter is the per-endpoint token-bucket rate limiter. Limits mirror
+// BTSE's documented per-endpoint caps; the default covers any endpoint not
+// listed explicitly.
+type restLimiter struct {
+	limits   map[string]*rate.Limiter
+	fallback *rate.Limiter
+}
+
+func newRestLimiter() *restLimiter {
+	return &restLimiter{
+		limits: map[string]*rate.Limiter{
+			"/order":     rate.NewLimiter(rate.Limit(15), 15),
+			"/orders":    rate.NewLimiter(rate.Limit(15), 15),
+			"/positions": rate.NewLimiter(rate.Limit(10), 10),
+			"/user":      rate.NewLimiter(rate.Limit(10), 10),
+		},
+		fallback: rate.NewLimiter(rate.Limit(5), 5),
+	}
+}
+
+func (l *restLimiter) wait(ctx context.Context, path string) error {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	limiter, ok := l.limits[path]
+	if !ok {
+		limiter = l.fallback
+	}
+	return limiter.Wait(ctx)
+}
+
+func getPrivateHeaders(req *http.Request, apiKey, apiSecret, path string, data map[string]interface{}) error {
+	var jsonStr string
+	if len(data) > 0 {
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("marshal request body: %w", err)
+		}
+		jsonStr = string(jsonData)
+	}
+
+	n := nonce()
+	signature := generateSignature(apiSecret, path, n, jsonStr)
+
+	req.Header.Set("request-api", apiKey)
+	req.Header.Set("request-nonce", n)
+	req.Header.Set("request-sign", signature)
+	return nil
+}
+
+// do sends a signed REST request, respecting the per-endpoint rate limit
+// and retrying on 429/5xx with exponential backoff, then decodes the
+// response body into result.
+func (c *Client) do(ctx context.Context, method, path string, body map[string]interface{}, result interface{}) error {
+	if err := c.restLimiter.wait(ctx, path); err != nil {
+		return fmt.Errorf("rate limiter: %w", err)
+	}
+
+	var bodyBytes []byte
+	if len(body) > 0 {
+		var err error
+		bodyBytes, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal body: %w", err)
+		}
+	}
+
+	wait := retryBaseWait
+	var lastErr error
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+			wait *= 2
+			if wait > retryMaxWait {
+				wait = retryMaxWait
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, restBaseURL+path, bytes.NewReader(bodyBytes))
+		if err != nil {
+			return fmt.Errorf("new request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if err := getPrivateHeaders(req, c.config.ApiKey, c.config.ApiSecret, path, body); err != nil {
+			return err
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		respBody, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = fmt.Errorf("read response: %w", err)
+			continue
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			lastErr = &APIError{HTTPStatus: resp.StatusCode, Message: string(respBody)}
+			continue
+		}
+
+		if resp.StatusCode >= 400 {
+			var apiErr struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+			}
+			_ = json.Unmarshal(respBody, &apiErr)
+			return &APIError{Code: apiErr.Code, Message: apiErr.Message, HTTPStatus: resp.StatusCode}
+		}
+
+		if result == nil {
+			return nil
+		}
+		return json.Unmarshal(respBody, result)
+	}
+	return lastErr
+}
+
+// RoundPrice rounds price to symbol's tick size and price precision so
+// callers cannot submit orders that violate the exchange's precision
+// rules.
+func (c *Client) RoundPrice(symbol string, price float64) float64 {
+	c.mu.RLock()
+	instrument, ok := c.instrument[symbol]
+	c.mu.RUnlock()
+	if !ok || instrument.TickSize == 0 {
+		return price
+	}
+	rounded := math.Round(price/instrument.TickSize) * instrument.TickSize
+	return roundToPrecision(rounded, instrument.PricePrecision)
+}
+
+// RoundSize rounds size to symbol's step size and quantity precision so
+// callers cannot submit orders that violate the exchange's precision
+// rules.
+func (c *Client) RoundSize(symbol string, size float64) float64 {
+	c.mu.RLock()
+	instrument, ok := c.instrument[symbol]
+	c.mu.RUnlock()
+	if !ok || instrument.StepSize == 0 {
+		return size
+	}
+	rounded := math.Round(size/instrument.StepSize) * instrument.StepSize
+	return roundToPrecision(rounded, instrument.QuantityPrecision)
+}
+
+func roundToPrecision(v float64, precision int) float64 {
+	factor := math.Pow(10, float64(precision))
+	return math.Round(v*factor) / factor
+}
+
+// PlaceOrderRequest describes a new order. Price is ignored for market
+// orders.
+type PlaceOrderRequest struct {
+	Symbol string
+	Side   string
+	Type   string
+	Price  float64
+	Size   float64
+}
+
+// PlaceOrder submits a new order, rounding price and size to the symbol's
+// precision before sending.
+func (c *Client) PlaceOrder(ctx context.Context, req PlaceOrderRequest) (*Order, error) {
+	body := map[string]interface{}{
+		"symbol": req.Symbol,
+		"side":   req.Side,
+		"type":   req.Type,
+		"price":  c.RoundPrice(req.Symbol, req.Price),
+		"size":   c.RoundSize(req.Symbol, req.Size),
+	}
+	var order Order
+	if err := c.do(ctx, http.MethodPost, "/order", body, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// CancelOrder cancels an open order by ID.
+func (c *Client) CancelOrder(ctx context.Context, symbol, orderID string) (*Order, error) {
+	body := map[string]interface{}{
+		"symbol":  symbol,
+		"orderID": orderID,
+	}
+	var order Order
+	if err := c.do(ctx, http.MethodDelete, "/order", body, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// AmendOrderRequest describes an in-place modification to an open order.
+type AmendOrderRequest struct {
+	Symbol  string
+	OrderID string
+	Price   float64
+	Size    float64
+}
+
+// AmendOrder changes the price and/or size of an open order, rounding
+// both to the symbol's precision before sending.
+func (c *Client) AmendOrder(ctx context.Context, req AmendOrderRequest) (*Order, error) {
+	body := map[string]interface{}{
+		"symbol":  req.Symbol,
+		"orderID": req.OrderID,
+		"price":   c.RoundPrice(req.Symbol, req.Price),
+		"size":    c.RoundSize(req.Symbol, req.Size),
+	}
+	var order Order
+	if err := c.do(ctx, http.MethodPut, "/order", body, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// GetPositions returns all open futures positions on the account.
+func (c *Client) GetPositions(ctx context.Context) ([]Position, error) {
+	var positions []Position
+	if err := c.do(ctx, http.MethodGet, "/positions", nil, &positions); err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+// GetOpenOrders returns the account's open orders, optionally filtered by
+// symbol (pass "" for all symbols).
+func (c *Client) GetOpenOrders(ctx context.Context, symbol string) ([]Order, error) {
+	path := "/orders"
+	if symbol != "" {
+		path += "?symbol=" + symbol
+	}
+	var orders []Order
+	if err := c.do(ctx, http.MethodGet, path, nil, &orders); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetAccount returns the futures account balance summary.
+func (c *Client) GetAccount(ctx context.Context) (*Account, error) {
+	var account Account
+	if err := c.do(ctx, http.MethodGet, "/user", nil, &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
@@ -0,0 +1,227 @@
+package btse
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/NumberUn/go_bot/pkg/types"
+	"github.com/gorilla/websocket"
+)
+
+// ConnectionState describes the health of the public order book websocket,
+// published on the channel returned by Client.ConnectionState so trading
+// logic can pause while the feed is degraded instead of acting on stale
+// books.
+type ConnectionState int
+
+const (
+	StateDisconnected ConnectionState = iota
+	StateConnecting
+	StateConnected
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case StateConnecting:
+		return "connecting"
+	case StateConnected:
+		return "connected"
+	default:
+		return "disconnected"
+	}
+}
+
+const (
+	pingInterval   = 25 * time.Second
+	pongTimeout    = 3 * pingInterval
+	initialBackoff = time.Second
+	maxBackoff     = time.Minute
+)
+
+// Subscription is the generic subscribe/unsubscribe envelope BTSE's public
+// websocket API expects.
+type Subscription struct {
+	Op   string   `json:"op"`
+	Args []string `json:"args"`
+}
+
+func connect(endpoint string) (*websocket.Conn, error) {
+	u := url.URL{Scheme: "wss", Host: wsPublicAddr, Path: endpoint}
+	fmt.Println(u)
+	c, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	return c, err
+}
+
+func subscribeOrderbook(conn *websocket.Conn, channels []string) error {
+	subscription := Subscription{
+		Op:   "subscribe",
+		Args: channels,
+	}
+	message, err := json.Marshal(subscription)
+	if err != nil {
+		return fmt.Errorf("marshal subscription: %w", err)
+	}
+	if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+		return fmt.Errorf("write subscription: %w", err)
+	}
+	return nil
+}
+
+// pingWs pings conn on a fixed interval and closes it if a pong has not
+// been seen within pongTimeout, so a silent half-open TCP connection gets
+// torn down instead of producing stale top-of-book indefinitely. It
+// returns when conn is closed by either side.
+func pingWs(conn *websocket.Conn) {
+	var lastPong atomic.Int64
+	lastPong.Store(time.Now().UnixNano())
+	conn.SetPongHandler(func(string) error {
+		lastPong.Store(time.Now().UnixNano())
+		return nil
+	})
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if time.Since(time.Unix(0, lastPong.Load())) > pongTimeout {
+			log.Println("pong timeout, closing connection")
+			conn.Close()
+			return
+		}
+		if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			log.Println("ping:", err)
+			return
+		}
+	}
+}
+
+func (c *Client) readMessages(conn *websocket.Conn, updates chan<- types.OrderBookUpdate) {
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			log.Println("read:", err)
+			return
+		}
+
+		var msg WsOrderbookResp
+		if err := json.Unmarshal(message, &msg); err != nil {
+			log.Println("unmarshal:", err)
+			continue
+		}
+
+		update, ok := c.updateOrderBook(msg)
+		if !ok {
+			log.Println("orderbook out of sync, resyncing:", msg.Data.Symbol)
+			c.resyncSymbol(msg.Data.Symbol)
+			if err := subscribeOrderbook(conn, []string{"update:" + msg.Data.Symbol + "_0"}); err != nil {
+				log.Println("resubscribe:", err)
+			}
+			continue
+		}
+		updates <- update
+	}
+}
+
+func backoffWithJitter(attempt time.Duration) time.Duration {
+	jitter := time.Duration(rand.Int63n(int64(attempt) / 2))
+	return attempt + jitter
+}
+
+func channelsFor(symbols []string) []string {
+	channels := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		channels = append(channels, "update:"+symbol+"_0")
+	}
+	return channels
+}
+
+// ConnectionState returns a channel on which the client publishes its
+// public websocket connection health. The channel is unbuffered past its
+// last value: slow consumers only see the most recent state, not a replay
+// of every transition.
+func (c *Client) ConnectionState() <-chan ConnectionState {
+	return c.states
+}
+
+func (c *Client) publishState(state ConnectionState) {
+	for {
+		select {
+		case c.states <- state:
+			return
+		default:
+		}
+		// Buffer full: discard the unread older state so the newest wins.
+		select {
+		case <-c.states:
+		default:
+		}
+	}
+}
+
+// runOrderbookWebsocket is the supervised connection manager: it redials
+// on any read error or missed-pong disconnect, applies exponential
+// backoff with jitter between attempts, resends the subscription list on
+// every reconnect, and drops the affected symbols' local books so stale
+// state never leaks into consumers across a reconnect.
+func (c *Client) runOrderbookWebsocket(symbols []string, updates chan<- types.OrderBookUpdate) {
+	backoff := initialBackoff
+	channels := channelsFor(symbols)
+
+	for {
+		c.publishState(StateConnecting)
+
+		conn, err := connect(wsPublicPath)
+		if err != nil {
+			log.Println("connect:", err)
+			c.publishState(StateDisconnected)
+			time.Sleep(backoffWithJitter(backoff))
+			backoff = nextBackoff(backoff)
+			continue
+		}
+
+		if err := subscribeOrderbook(conn, channels); err != nil {
+			log.Println("subscribe:", err)
+			conn.Close()
+			c.publishState(StateDisconnected)
+			time.Sleep(backoffWithJitter(backoff))
+			backoff = nextBackoff(backoff)
+			continue
+		}
+
+		for _, symbol := range symbols {
+			c.resyncSymbol(symbol)
+		}
+
+		c.publishState(StateConnected)
+		backoff = initialBackoff
+
+		go pingWs(conn)
+		c.readMessages(conn, updates)
+		conn.Close()
+
+		c.publishState(StateDisconnected)
+		time.Sleep(backoffWithJitter(backoff))
+		backoff = nextBackoff(backoff)
+	}
+}
+
+func nextBackoff(current time.Duration) time.Duration {
+	next := current * 2
+	if next > maxBackoff {
+		return maxBackoff
+	}
+	return next
+}
+
+// SubscribeOrderBook implements exchange.Exchange: it starts the
+// supervised websocket connection manager for the given symbols and
+// returns the channel it publishes unified order book updates on.
+func (c *Client) SubscribeOrderBook(symbols []string) (<-chan types.OrderBookUpdate, error) {
+	updates := make(chan types.OrderBookUpdate)
+	go c.runOrderbookWebsocket(symbols, updates)
+	return updates, nil
+}
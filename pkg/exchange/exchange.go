@@ -0,0 +1,22 @@
+// Package exchange defines the interface every venue implementation
+// (btse, whitebit, ...) must satisfy so the rest of go_bot can treat them
+// interchangeably.
+package exchange
+
+import "github.com/NumberUn/go_bot/pkg/types"
+
+// Exchange is the minimal surface a venue adapter exposes to the rest of
+// the bot: market data in, unified types out.
+type Exchange interface {
+	// Name returns the exchange identifier used in types.OrderBookUpdate.Exchange.
+	Name() string
+
+	// Markets returns the tradeable symbols known to this exchange, keyed
+	// by base asset.
+	Markets() map[string]types.Market
+
+	// SubscribeOrderBook starts streaming order book updates for the given
+	// symbols and returns a channel of updates. The channel is closed when
+	// the subscription is torn down.
+	SubscribeOrderBook(symbols []string) (<-chan types.OrderBookUpdate, error)
+}
@@ -0,0 +1,113 @@
+// Package whitebit implements the exchange.Exchange interface for
+// Whitebit perpetual futures. Whitebit's public API only exposes order
+// book snapshots over REST, so SubscribeOrderBook polls instead of
+// streaming over a websocket the way btse.Client does.
+package whitebit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NumberUn/go_bot/pkg/types"
+)
+
+const (
+	name            = "WHITEBIT"
+	orderbookUrlFmt = "https://whitebit.com/api/v4/public/orderbook/%s?limit=%d"
+	pollInterval    = time.Second
+	depth           = 10
+)
+
+// orderbookResp is the shape of Whitebit's public order book endpoint:
+// bids/asks as [price, size] string pairs, best price first.
+type orderbookResp struct {
+	Bids [][2]string `json:"bids"`
+	Asks [][2]string `json:"asks"`
+}
+
+// Client is a Whitebit adapter. It currently only implements the public
+// order book subscription; it has no notion of markets until a markets
+// endpoint is wired in.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient builds a Whitebit client.
+func NewClient() *Client {
+	return &Client{httpClient: &http.Client{Timeout: 5 * time.Second}}
+}
+
+// Name identifies this adapter in types.OrderBookUpdate.Exchange.
+func (c *Client) Name() string {
+	return name
+}
+
+// Markets is not yet implemented for Whitebit; it returns an empty map.
+func (c *Client) Markets() map[string]types.Market {
+	return map[string]types.Market{}
+}
+
+func parseLevels(raw [][2]string) []types.PriceLevel {
+	levels := make([]types.PriceLevel, 0, len(raw))
+	for _, level := range raw {
+		price, _ := strconv.ParseFloat(level[0], 64)
+		size, _ := strconv.ParseFloat(level[1], 64)
+		levels = append(levels, types.PriceLevel{Price: price, Size: size})
+	}
+	return levels
+}
+
+func (c *Client) fetchOrderbook(symbol string) (types.OrderBookUpdate, error) {
+	resp, err := c.httpClient.Get(fmt.Sprintf(orderbookUrlFmt, symbol, depth))
+	if err != nil {
+		return types.OrderBookUpdate{}, fmt.Errorf("fetch orderbook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return types.OrderBookUpdate{}, fmt.Errorf("read orderbook response: %w", err)
+	}
+
+	var parsed orderbookResp
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return types.OrderBookUpdate{}, fmt.Errorf("unmarshal orderbook: %w", err)
+	}
+
+	return types.OrderBookUpdate{
+		Exchange:  name,
+		Symbol:    symbol,
+		Bids:      parseLevels(parsed.Bids),
+		Asks:      parseLevels(parsed.Asks),
+		Timestamp: time.Now().UnixMilli(),
+	}, nil
+}
+
+// SubscribeOrderBook implements exchange.Exchange by polling Whitebit's
+// REST order book endpoint for each symbol at pollInterval and publishing
+// unified updates until the returned channel's consumer stops reading.
+func (c *Client) SubscribeOrderBook(symbols []string) (<-chan types.OrderBookUpdate, error) {
+	updates := make(chan types.OrderBookUpdate)
+
+	go func() {
+		defer close(updates)
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			for _, symbol := range symbols {
+				update, err := c.fetchOrderbook(symbol)
+				if err != nil {
+					fmt.Println("Error fetching Whitebit orderbook:", err)
+					continue
+				}
+				updates <- update
+			}
+		}
+	}()
+
+	return updates, nil
+}
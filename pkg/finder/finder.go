@@ -0,0 +1,159 @@
+// Package finder implements the cross-exchange spread finder that the
+// original UpdateOrderBook comment in the BTSE-only client left as a
+// placeholder ("Additional logic based on `side` and `finder`..."). It
+// watches the best bid/ask each exchange publishes for a base asset and
+// emits SpreadOpportunity events when buying on one venue and selling on
+// another clears a configurable threshold.
+package finder
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NumberUn/go_bot/pkg/types"
+)
+
+// VenueQuote is a single exchange's latest top-of-book for a base asset,
+// along with the instrument details the finder needs to size and cost an
+// opportunity.
+type VenueQuote struct {
+	Exchange      string
+	Base          string
+	Bid           types.PriceLevel
+	Ask           types.PriceLevel
+	MinSize       float64
+	ContractValue float64
+	FeeBps        float64
+	Timestamp     int64 // exchange-reported ms timestamp of the book update
+}
+
+// SpreadOpportunity is emitted on Finder.Opportunities whenever buying on
+// BuyVenue and selling on SellVenue clears the configured threshold, net
+// of both venues' fees.
+type SpreadOpportunity struct {
+	Base      string
+	BuyVenue  string
+	SellVenue string
+	BuyPrice  float64
+	SellPrice float64
+	MaxSize   float64
+	Bps       float64
+	Timestamp int64
+}
+
+// Finder tracks the latest quote per base asset per exchange and computes
+// the best buy-here/sell-there spread across venues on every update.
+type Finder struct {
+	thresholdBps  float64
+	maxStaleness  time.Duration
+	opportunities chan SpreadOpportunity
+
+	mu    sync.Mutex
+	books map[string]map[string]VenueQuote // base -> exchange -> latest quote
+}
+
+// New returns a Finder that emits opportunities whose net spread exceeds
+// thresholdBps, ignoring any quote older than maxStaleness.
+func New(thresholdBps float64, maxStaleness time.Duration) *Finder {
+	return &Finder{
+		thresholdBps:  thresholdBps,
+		maxStaleness:  maxStaleness,
+		opportunities: make(chan SpreadOpportunity, 64),
+		books:         make(map[string]map[string]VenueQuote),
+	}
+}
+
+// Opportunities returns the channel SpreadOpportunity events are published
+// on.
+func (f *Finder) Opportunities() <-chan SpreadOpportunity {
+	return f.opportunities
+}
+
+// Update records a venue's latest quote for its base asset and checks it
+// against every other venue's latest quote for the same base, emitting a
+// SpreadOpportunity for each pair that clears the threshold. Update is
+// safe to call concurrently from each exchange's own watchOrderBook
+// goroutine.
+func (f *Finder) Update(q VenueQuote) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	venues, ok := f.books[q.Base]
+	if !ok {
+		venues = make(map[string]VenueQuote)
+		f.books[q.Base] = venues
+	}
+	venues[q.Exchange] = q
+
+	now := time.Now().UnixMilli()
+	for exchange, other := range venues {
+		if exchange == q.Exchange {
+			continue
+		}
+		if f.stale(q, now) || f.stale(other, now) {
+			continue
+		}
+		if opp, ok := spread(q, other, f.thresholdBps); ok {
+			f.emit(opp)
+		}
+		if opp, ok := spread(other, q, f.thresholdBps); ok {
+			f.emit(opp)
+		}
+	}
+}
+
+func (f *Finder) stale(q VenueQuote, nowMs int64) bool {
+	return time.Duration(nowMs-q.Timestamp)*time.Millisecond > f.maxStaleness
+}
+
+func (f *Finder) emit(opp SpreadOpportunity) {
+	select {
+	case f.opportunities <- opp:
+	default:
+		// Consumer is behind; drop rather than block the update path.
+	}
+}
+
+// spread evaluates buying on buy.Ask and selling on sell.Bid, net of both
+// venues' taker fees, and returns the resulting opportunity if it clears
+// thresholdBps.
+func spread(buy, sell VenueQuote, thresholdBps float64) (SpreadOpportunity, bool) {
+	if buy.Ask.Price <= 0 || sell.Bid.Price <= 0 {
+		return SpreadOpportunity{}, false
+	}
+
+	buyCost := buy.Ask.Price * (1 + buy.FeeBps/10000)
+	sellProceeds := sell.Bid.Price * (1 - sell.FeeBps/10000)
+	if sellProceeds <= buyCost {
+		return SpreadOpportunity{}, false
+	}
+
+	bps := (sellProceeds - buyCost) / buyCost * 10000
+	if bps <= thresholdBps {
+		return SpreadOpportunity{}, false
+	}
+
+	maxSize := buy.Ask.Size * buy.ContractValue
+	if sellSize := sell.Bid.Size * sell.ContractValue; sellSize < maxSize {
+		maxSize = sellSize
+	}
+	if maxSize < buy.MinSize || maxSize < sell.MinSize {
+		return SpreadOpportunity{}, false
+	}
+
+	timestamp := buy.Timestamp
+	if sell.Timestamp > timestamp {
+		timestamp = sell.Timestamp
+	}
+
+	return SpreadOpportunity{
+		Base:      buy.Base,
+		BuyVenue:  buy.Exchange,
+		SellVenue: sell.Exchange,
+		BuyPrice:  buy.Ask.Price,
+		SellPrice: sell.Bid.Price,
+		MaxSize:   maxSize,
+		Bps:       bps,
+		Timestamp: timestamp,
+	}, true
+}
@@ -0,0 +1,96 @@
+package finder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NumberUn/go_bot/pkg/types"
+)
+
+func TestUpdateEmitsOpportunityAboveThreshold(t *testing.T) {
+	f := New(10, time.Minute)
+	now := time.Now().UnixMilli()
+
+	f.Update(VenueQuote{
+		Exchange:      "BTSE",
+		Base:          "BTC",
+		Bid:           types.PriceLevel{Price: 100, Size: 1},
+		Ask:           types.PriceLevel{Price: 100.1, Size: 1},
+		ContractValue: 1,
+		Timestamp:     now,
+	})
+	f.Update(VenueQuote{
+		Exchange:      "WHITEBIT",
+		Base:          "BTC",
+		Bid:           types.PriceLevel{Price: 102, Size: 1},
+		Ask:           types.PriceLevel{Price: 102.1, Size: 1},
+		ContractValue: 1,
+		Timestamp:     now,
+	})
+
+	select {
+	case opp := <-f.Opportunities():
+		if opp.BuyVenue != "BTSE" || opp.SellVenue != "WHITEBIT" {
+			t.Fatalf("unexpected opportunity: %+v", opp)
+		}
+	default:
+		t.Fatal("expected an opportunity, got none")
+	}
+}
+
+func TestUpdateSkipsStaleQuote(t *testing.T) {
+	f := New(10, time.Second)
+	now := time.Now().UnixMilli()
+
+	f.Update(VenueQuote{
+		Exchange:      "BTSE",
+		Base:          "BTC",
+		Bid:           types.PriceLevel{Price: 100, Size: 1},
+		Ask:           types.PriceLevel{Price: 100.1, Size: 1},
+		ContractValue: 1,
+		Timestamp:     now - 5000,
+	})
+	f.Update(VenueQuote{
+		Exchange:      "WHITEBIT",
+		Base:          "BTC",
+		Bid:           types.PriceLevel{Price: 102, Size: 1},
+		Ask:           types.PriceLevel{Price: 102.1, Size: 1},
+		ContractValue: 1,
+		Timestamp:     now,
+	})
+
+	select {
+	case opp := <-f.Opportunities():
+		t.Fatalf("expected no opportunity due to staleness, got %+v", opp)
+	default:
+	}
+}
+
+func TestUpdateSkipsBelowMinSize(t *testing.T) {
+	f := New(10, time.Minute)
+	now := time.Now().UnixMilli()
+
+	f.Update(VenueQuote{
+		Exchange:      "BTSE",
+		Base:          "BTC",
+		Bid:           types.PriceLevel{Price: 100, Size: 0.001},
+		Ask:           types.PriceLevel{Price: 100.1, Size: 0.001},
+		ContractValue: 1,
+		MinSize:       1,
+		Timestamp:     now,
+	})
+	f.Update(VenueQuote{
+		Exchange:      "WHITEBIT",
+		Base:          "BTC",
+		Bid:           types.PriceLevel{Price: 102, Size: 1},
+		Ask:           types.PriceLevel{Price: 102.1, Size: 1},
+		ContractValue: 1,
+		Timestamp:     now,
+	})
+
+	select {
+	case opp := <-f.Opportunities():
+		t.Fatalf("expected no opportunity below min size, got %+v", opp)
+	default:
+	}
+}
@@ -0,0 +1,125 @@
+// Package orderbook implements a price-sorted local order book, shared by
+// every exchange adapter so that the best price after a level deletion is
+// a slice read instead of the O(n) map scan the original BTSE-only client
+// did.
+package orderbook
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/NumberUn/go_bot/pkg/types"
+)
+
+// Side identifies which side of the book a level belongs to.
+type Side int
+
+const (
+	Bid Side = iota
+	Ask
+)
+
+// OrderBook is a price-sorted local book for a single symbol. Bids are
+// kept sorted highest-first, asks lowest-first, so BestBidAsk and TopN are
+// simple slice reads and a level is located by binary search (O(log n))
+// rather than by rescanning every price for the new best. Inserting or
+// deleting a level still shifts the slice, which is O(n).
+type OrderBook struct {
+	Symbol string
+
+	mu   sync.RWMutex
+	bids []types.PriceLevel // descending by price
+	asks []types.PriceLevel // ascending by price
+}
+
+// New returns an empty order book for symbol.
+func New(symbol string) *OrderBook {
+	return &OrderBook{Symbol: symbol}
+}
+
+func search(levels []types.PriceLevel, price float64, ascending bool) int {
+	return sort.Search(len(levels), func(i int) bool {
+		if ascending {
+			return levels[i].Price >= price
+		}
+		return levels[i].Price <= price
+	})
+}
+
+// Update upserts the level at price on side. A size of 0 removes the level,
+// matching the delta convention BTSE (and FTX-style feeds) use on the wire.
+func (ob *OrderBook) Update(side Side, price, size float64) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+
+	ascending := side == Ask
+	levels := ob.bids
+	if side == Ask {
+		levels = ob.asks
+	}
+
+	i := search(levels, price, ascending)
+	found := i < len(levels) && levels[i].Price == price
+
+	switch {
+	case size == 0 && found:
+		levels = append(levels[:i], levels[i+1:]...)
+	case size == 0:
+		// nothing to remove
+	case found:
+		levels[i].Size = size
+	default:
+		levels = append(levels, types.PriceLevel{})
+		copy(levels[i+1:], levels[i:])
+		levels[i] = types.PriceLevel{Price: price, Size: size}
+	}
+
+	if side == Bid {
+		ob.bids = levels
+	} else {
+		ob.asks = levels
+	}
+}
+
+// TopN returns up to n price levels on side, best price first.
+func (ob *OrderBook) TopN(side Side, n int) []types.PriceLevel {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+
+	levels := ob.bids
+	if side == Ask {
+		levels = ob.asks
+	}
+	if n > len(levels) {
+		n = len(levels)
+	}
+	out := make([]types.PriceLevel, n)
+	copy(out, levels[:n])
+	return out
+}
+
+// BestBidAsk returns the top of book on each side. A zero-value PriceLevel
+// is returned for a side with no levels.
+func (ob *OrderBook) BestBidAsk() (bid, ask types.PriceLevel) {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+
+	if len(ob.bids) > 0 {
+		bid = ob.bids[0]
+	}
+	if len(ob.asks) > 0 {
+		ask = ob.asks[0]
+	}
+	return bid, ask
+}
+
+// SpreadBps returns the bid/ask spread in basis points of the mid price, or
+// 0 if either side of the book is empty.
+func (ob *OrderBook) SpreadBps() float64 {
+	bid, ask := ob.BestBidAsk()
+	if bid.Price == 0 || ask.Price == 0 {
+		return 0
+	}
+	mid := (bid.Price + ask.Price) / 2
+	return (ask.Price - bid.Price) / mid * 10000
+}
@@ -0,0 +1,96 @@
+package orderbook
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/NumberUn/go_bot/pkg/types"
+)
+
+// naiveBest scans all levels the way the old BTSE findHighestBidPrice/
+// findLowestAskPrice did, used here as the oracle the sorted book is
+// fuzzed against.
+func naiveBest(levels map[float64]float64, side Side) (price, size float64) {
+	first := true
+	for p, s := range levels {
+		if first {
+			price, size, first = p, s, false
+			continue
+		}
+		if side == Bid && p > price {
+			price, size = p, s
+		}
+		if side == Ask && p < price {
+			price, size = p, s
+		}
+	}
+	return price, size
+}
+
+func TestOrderBookFuzzAgainstNaiveScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	ob := New("TEST")
+	naiveBids := make(map[float64]float64)
+	naiveAsks := make(map[float64]float64)
+
+	for i := 0; i < 5000; i++ {
+		side := Bid
+		naive := naiveBids
+		if rng.Intn(2) == 1 {
+			side = Ask
+			naive = naiveAsks
+		}
+
+		price := float64(rng.Intn(100))
+		remove := rng.Intn(4) == 0 && len(naive) > 0
+
+		if remove {
+			ob.Update(side, price, 0)
+			delete(naive, price)
+		} else {
+			size := float64(rng.Intn(10) + 1)
+			ob.Update(side, price, size)
+			naive[price] = size
+		}
+
+		wantBidPrice, wantBidSize := naiveBest(naiveBids, Bid)
+		wantAskPrice, wantAskSize := naiveBest(naiveAsks, Ask)
+
+		gotBid, gotAsk := ob.BestBidAsk()
+		if len(naiveBids) > 0 && (gotBid.Price != wantBidPrice || gotBid.Size != wantBidSize) {
+			t.Fatalf("step %d: best bid = %+v, want {%v %v}", i, gotBid, wantBidPrice, wantBidSize)
+		}
+		if len(naiveAsks) > 0 && (gotAsk.Price != wantAskPrice || gotAsk.Size != wantAskSize) {
+			t.Fatalf("step %d: best ask = %+v, want {%v %v}", i, gotAsk, wantAskPrice, wantAskSize)
+		}
+	}
+}
+
+func TestOrderBookTopN(t *testing.T) {
+	ob := New("TEST")
+	ob.Update(Bid, 100, 1)
+	ob.Update(Bid, 102, 1)
+	ob.Update(Bid, 101, 1)
+
+	got := ob.TopN(Bid, 2)
+	want := []types.PriceLevel{{Price: 102, Size: 1}, {Price: 101, Size: 1}}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("TopN(Bid, 2) = %+v, want %+v", got, want)
+	}
+}
+
+func TestSpreadBps(t *testing.T) {
+	ob := New("TEST")
+	ob.Update(Bid, 100, 1)
+	ob.Update(Ask, 101, 1)
+
+	bid, ask := 100.0, 101.0
+	mid := (bid + ask) / 2
+	want := (ask - bid) / mid * 10000
+
+	got := ob.SpreadBps()
+	if math.Abs(got-want) > 1e-9 {
+		t.Fatalf("SpreadBps() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,43 @@
+// Package types holds the data structures shared by every exchange
+// implementation under pkg/exchange, so strategies and the finder can work
+// with a single vocabulary regardless of which venue produced the data.
+package types
+
+// Instrument describes the tradeable contract for a symbol on a given
+// exchange, derived from that exchange's market/instrument REST endpoint.
+type Instrument struct {
+	ContractValue     float64
+	TickSize          float64
+	StepSize          float64
+	QuantityPrecision int
+	PricePrecision    int
+	MinSize           float64
+}
+
+// Market is the venue-agnostic description of a tradeable symbol, filled in
+// from each exchange's market summary endpoint.
+type Market struct {
+	Base              string
+	Symbol            string
+	Active            bool
+	MinPriceIncrement float64
+	MinSizeIncrement  float64
+	ContractSize      float64
+	MinOrderSize      float64
+}
+
+// PriceLevel is a single price/size pair on one side of an order book.
+type PriceLevel struct {
+	Price float64
+	Size  float64
+}
+
+// OrderBookUpdate is emitted on the channel returned by
+// Exchange.SubscribeOrderBook every time a symbol's book changes.
+type OrderBookUpdate struct {
+	Exchange  string
+	Symbol    string
+	Bids      []PriceLevel
+	Asks      []PriceLevel
+	Timestamp int64
+}